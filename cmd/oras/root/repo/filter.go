@@ -0,0 +1,86 @@
+/*
+Copyright The ORAS Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+)
+
+// errStopListing is returned from a page callback once --limit matches have
+// been emitted, to short-circuit the paginated walk. It is translated back
+// into a clean success by the caller and never surfaces to the user.
+var errStopListing = errors.New("stop listing: limit reached")
+
+// nameFilter implements the --match, --match-regex and --limit flags shared
+// by repo ls and repo tags.
+type nameFilter struct {
+	glob  string
+	regex *regexp.Regexp
+	limit int
+	count int
+}
+
+// newNameFilter builds a nameFilter from the raw flag values. match and
+// matchRegex are mutually exclusive; limit of 0 or less means unlimited.
+func newNameFilter(match, matchRegex string, limit int) (*nameFilter, error) {
+	if match != "" && matchRegex != "" {
+		return nil, errors.New("--match and --match-regex cannot be used together")
+	}
+	if match != "" {
+		if _, err := path.Match(match, ""); err != nil {
+			return nil, fmt.Errorf("invalid --match pattern %q: %w", match, err)
+		}
+	}
+	f := &nameFilter{glob: match, limit: limit}
+	if matchRegex != "" {
+		re, err := regexp.Compile(matchRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --match-regex pattern %q: %w", matchRegex, err)
+		}
+		f.regex = re
+	}
+	return f, nil
+}
+
+// accept reports whether name passes the configured --match/--match-regex
+// filter. Once --limit matches have been accepted, it additionally returns
+// errStopListing so the caller can abort the paginated walk after emitting
+// this last match.
+func (f *nameFilter) accept(name string) (bool, error) {
+	var matched bool
+	switch {
+	case f.regex != nil:
+		matched = f.regex.MatchString(name)
+	case f.glob != "":
+		var err error
+		if matched, err = path.Match(f.glob, name); err != nil {
+			return false, err
+		}
+	default:
+		matched = true
+	}
+	if !matched {
+		return false, nil
+	}
+	f.count++
+	if f.limit > 0 && f.count >= f.limit {
+		return true, errStopListing
+	}
+	return true, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright The ORAS Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewNameFilter_RejectsBothMatchFlags(t *testing.T) {
+	if _, err := newNameFilter("foo-*", "^foo-", 0); err == nil {
+		t.Fatal("expected an error when both --match and --match-regex are set")
+	}
+}
+
+func TestNewNameFilter_InvalidPattern(t *testing.T) {
+	if _, err := newNameFilter("[", "", 0); err == nil {
+		t.Fatal("expected an error for a malformed --match glob pattern")
+	}
+	if _, err := newNameFilter("", "(", 0); err == nil {
+		t.Fatal("expected an error for a malformed --match-regex pattern")
+	}
+}
+
+func TestNameFilter_Accept_Glob(t *testing.T) {
+	f, err := newNameFilter("v1.*", "", 0)
+	if err != nil {
+		t.Fatalf("newNameFilter: %v", err)
+	}
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"v1.0", true},
+		{"v1.2.3", true}, // path.Match "*" matches any run of non-'/' characters, including '.'
+		{"v2.0", false},
+	}
+	for _, tt := range tests {
+		ok, err := f.accept(tt.name)
+		if err != nil {
+			t.Fatalf("accept(%q): unexpected error %v", tt.name, err)
+		}
+		if ok != tt.want {
+			t.Errorf("accept(%q) = %v, want %v", tt.name, ok, tt.want)
+		}
+	}
+}
+
+func TestNameFilter_Accept_Regex(t *testing.T) {
+	f, err := newNameFilter("", "^v1\\.[0-9]+$", 0)
+	if err != nil {
+		t.Fatalf("newNameFilter: %v", err)
+	}
+	for name, want := range map[string]bool{
+		"v1.0":  true,
+		"v1.10": true,
+		"v2.0":  false,
+		"v1.x":  false,
+	} {
+		ok, err := f.accept(name)
+		if err != nil {
+			t.Fatalf("accept(%q): unexpected error %v", name, err)
+		}
+		if ok != want {
+			t.Errorf("accept(%q) = %v, want %v", name, ok, want)
+		}
+	}
+}
+
+func TestNameFilter_Accept_NoPattern(t *testing.T) {
+	f, err := newNameFilter("", "", 0)
+	if err != nil {
+		t.Fatalf("newNameFilter: %v", err)
+	}
+	ok, err := f.accept("anything")
+	if err != nil || !ok {
+		t.Fatalf("accept(%q) = %v, %v; want true, nil", "anything", ok, err)
+	}
+}
+
+func TestNameFilter_Accept_Limit(t *testing.T) {
+	f, err := newNameFilter("", "", 2)
+	if err != nil {
+		t.Fatalf("newNameFilter: %v", err)
+	}
+
+	ok, err := f.accept("a")
+	if !ok || err != nil {
+		t.Fatalf("1st accept = %v, %v; want true, nil", ok, err)
+	}
+
+	ok, err = f.accept("b")
+	if !ok || !errors.Is(err, errStopListing) {
+		t.Fatalf("2nd accept = %v, %v; want true, errStopListing", ok, err)
+	}
+}
+
+func TestNameFilter_Accept_LimitCountsOnlyMatches(t *testing.T) {
+	f, err := newNameFilter("keep-*", "", 1)
+	if err != nil {
+		t.Fatalf("newNameFilter: %v", err)
+	}
+
+	ok, err := f.accept("skip-me")
+	if ok || err != nil {
+		t.Fatalf("accept(skip-me) = %v, %v; want false, nil", ok, err)
+	}
+
+	ok, err = f.accept("keep-me")
+	if !ok || !errors.Is(err, errStopListing) {
+		t.Fatalf("accept(keep-me) = %v, %v; want true, errStopListing", ok, err)
+	}
+}
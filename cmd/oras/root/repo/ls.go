@@ -18,9 +18,12 @@ package repo
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"oras.land/oras-go/v2/content/oci"
 	"oras.land/oras/cmd/oras/internal/argument"
 	"oras.land/oras/cmd/oras/internal/command"
 	"oras.land/oras/cmd/oras/internal/display"
@@ -36,6 +39,13 @@ type repositoryOptions struct {
 	hostname  string
 	namespace string
 	last      string
+
+	ociLayout bool
+	path      string
+
+	match      string
+	matchRegex string
+	limit      int
 }
 
 func listCmd() *cobra.Command {
@@ -54,11 +64,26 @@ Example - List the repositories under a namespace in the registry:
 Example - List the repositories under the registry that include values lexically after last:
   oras repo ls --last "last_repo" localhost:5000
 
+Example - [Experimental] List the repositories packed in the target OCI image layout folder 'layout-dir':
+  oras repo ls --oci-layout layout-dir
+
+Example - [Experimental] List the repositories packed in the target OCI layout archive 'layout.tar':
+  oras repo ls --oci-layout layout.tar
+
 Example - [Experimental] List the repositories under the registry in JSON format:
   oras repo ls localhost:5000 --format json
 
 Example - [Experimental] List the repositories under the registry using the given Go template:
   oras repo ls localhost:5000 --format go-template --template "{{.repositories}}"
+
+Example - [Experimental] List the repositories under the registry whose name matches a glob pattern:
+  oras repo ls --match "project-*" localhost:5000
+
+Example - [Experimental] List the repositories under the registry whose name matches a regular expression:
+  oras repo ls --match-regex "^project-[0-9]+$" localhost:5000
+
+Example - [Experimental] Stop after the first 10 matching repositories:
+  oras repo ls --limit 10 localhost:5000
 `,
 		Args:    oerrors.CheckArgs(argument.Exactly(1), "the target registry to list repositories from"),
 		Aliases: []string{"list"},
@@ -66,6 +91,10 @@ Example - [Experimental] List the repositories under the registry using the give
 			return option.Parse(cmd, &opts)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.ociLayout {
+				opts.path = args[0]
+				return listRepository(cmd, &opts)
+			}
 			var err error
 			if opts.hostname, opts.namespace, err = repository.ParseRepoPath(args[0]); err != nil {
 				return fmt.Errorf("could not parse repository path: %w", err)
@@ -75,6 +104,10 @@ Example - [Experimental] List the repositories under the registry using the give
 	}
 
 	cmd.Flags().StringVar(&opts.last, "last", "", "start after the repository specified by `last`")
+	cmd.Flags().BoolVar(&opts.ociLayout, "oci-layout", false, "[Preview] treat <registry> as an OCI image layout folder or tar archive")
+	cmd.Flags().StringVar(&opts.match, "match", "", "[Preview] only list repositories whose name matches the glob `pattern`")
+	cmd.Flags().StringVar(&opts.matchRegex, "match-regex", "", "[Preview] only list repositories whose name matches the regular `expression`")
+	cmd.Flags().IntVar(&opts.limit, "limit", 0, "[Preview] stop after `N` matching repositories are found, 0 means no limit")
 	option.AddDeprecatedVerboseFlag(cmd.Flags())
 	opts.SetTypes(option.FormatTypeText, option.FormatTypeJSON, option.FormatTypeGoTemplate)
 	option.ApplyFlags(&opts, cmd.Flags())
@@ -82,11 +115,19 @@ Example - [Experimental] List the repositories under the registry using the give
 }
 
 func listRepository(cmd *cobra.Command, opts *repositoryOptions) error {
+	if opts.ociLayout {
+		return listRepositoryFromOCILayout(cmd, opts)
+	}
+
 	ctx, logger := command.GetLogger(cmd, &opts.Common)
 	reg, err := opts.NewRegistry(opts.hostname, opts.Common, logger)
 	if err != nil {
 		return err
 	}
+	filter, err := newNameFilter(opts.match, opts.matchRegex, opts.limit)
+	if err != nil {
+		return err
+	}
 
 	handler, err := display.NewRepoListHandler(opts.Printer, opts.Format, reg.Reference.Registry, opts.namespace)
 	if err != nil {
@@ -96,15 +137,21 @@ func listRepository(cmd *cobra.Command, opts *repositoryOptions) error {
 		for _, repo := range repos {
 			if opts.namespace == "" || strings.HasPrefix(repo, opts.namespace) {
 				// list repositories under the specified namespace
-				if err := handler.OnRepositoryListed(repo); err != nil {
-					return err
+				ok, ferr := filter.accept(strings.TrimPrefix(repo, opts.namespace))
+				if ok {
+					if err := handler.OnRepositoryListed(repo); err != nil {
+						return err
+					}
+				}
+				if ferr != nil {
+					return ferr
 				}
 			}
 		}
 		return nil
 	})
 
-	if err != nil {
+	if err != nil && !errors.Is(err, errStopListing) {
 		var repoErr error
 		if opts.namespace != "" {
 			repoErr = fmt.Errorf("could not list repositories for %q with prefix %q", reg.Reference.Host(), opts.namespace)
@@ -116,3 +163,91 @@ func listRepository(cmd *cobra.Command, opts *repositoryOptions) error {
 
 	return handler.Render()
 }
+
+// listRepositoryFromOCILayout enumerates the repositories packed in an OCI
+// image layout by deriving a repository name from each ref name in
+// index.json, splitting on the last ':' to separate the repository from its
+// tag, and deduplicating the results. Ref names with no ':' are plain tags
+// (the common case for a layout produced by a single oras cp/pull) and are
+// attributed to a single fallback repository derived from the layout path,
+// rather than being reported as one bogus repository per tag.
+func listRepositoryFromOCILayout(cmd *cobra.Command, opts *repositoryOptions) error {
+	ctx := cmd.Context()
+
+	var store *oci.Store
+	var err error
+	if strings.HasSuffix(opts.path, ".tar") {
+		store, err = oci.NewFromTar(ctx, opts.path)
+	} else {
+		store, err = oci.New(opts.path)
+	}
+	if err != nil {
+		return fmt.Errorf("could not open OCI layout %q: %w", opts.path, err)
+	}
+
+	fallbackRepo := defaultRepoName(opts.path)
+	seen := make(map[string]struct{})
+	if err := store.Tags(ctx, "", func(refs []string) error {
+		for _, ref := range refs {
+			repo := repoNameFromRef(ref, fallbackRepo)
+			seen[repo] = struct{}{}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("could not list repositories for OCI layout %q: %w", opts.path, err)
+	}
+
+	repos := make([]string, 0, len(seen))
+	for repo := range seen {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	filter, err := newNameFilter(opts.match, opts.matchRegex, opts.limit)
+	if err != nil {
+		return err
+	}
+	handler, err := display.NewRepoListHandler(opts.Printer, opts.Format, opts.path, "")
+	if err != nil {
+		return err
+	}
+	for _, repo := range repos {
+		if opts.last != "" && repo <= opts.last {
+			continue
+		}
+		ok, ferr := filter.accept(repo)
+		if ok {
+			if err := handler.OnRepositoryListed(repo); err != nil {
+				return err
+			}
+		}
+		if ferr != nil {
+			if errors.Is(ferr, errStopListing) {
+				break
+			}
+			return ferr
+		}
+	}
+
+	return handler.Render()
+}
+
+// repoNameFromRef derives a repository name from an OCI layout ref name by
+// splitting on the last ':', which separates a "repo:tag" ref from its tag.
+// A ref with no ':' is a plain tag, so it is attributed to fallback instead
+// of being treated as its own repository.
+func repoNameFromRef(ref, fallback string) string {
+	i := strings.LastIndex(ref, ":")
+	if i == -1 {
+		return fallback
+	}
+	return ref[:i]
+}
+
+// defaultRepoName derives the fallback repository name used for plain-tag
+// ref names, from the layout's own path: the directory or archive name with
+// any ".tar" extension removed.
+func defaultRepoName(path string) string {
+	name := filepath.Base(path)
+	return strings.TrimSuffix(name, ".tar")
+}
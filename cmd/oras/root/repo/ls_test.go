@@ -0,0 +1,56 @@
+/*
+Copyright The ORAS Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import "testing"
+
+func TestRepoNameFromRef(t *testing.T) {
+	tests := []struct {
+		name     string
+		ref      string
+		fallback string
+		want     string
+	}{
+		{"repo and tag", "myrepo:v1", "fallback", "myrepo"},
+		{"nested repo and tag", "org/myrepo:v1", "fallback", "org/myrepo"},
+		{"plain tag falls back", "latest", "fallback", "fallback"},
+		{"plain semver tag falls back", "v1.0.0", "fallback", "fallback"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := repoNameFromRef(tt.ref, tt.fallback); got != tt.want {
+				t.Errorf("repoNameFromRef(%q, %q) = %q, want %q", tt.ref, tt.fallback, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultRepoName(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"layout-dir", "layout-dir"},
+		{"/tmp/layout-dir", "layout-dir"},
+		{"layout.tar", "layout"},
+		{"/tmp/layout.tar", "layout"},
+	}
+	for _, tt := range tests {
+		if got := defaultRepoName(tt.path); got != tt.want {
+			t.Errorf("defaultRepoName(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
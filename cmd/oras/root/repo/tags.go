@@ -16,10 +16,18 @@ limitations under the License.
 package repo
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"strings"
 
 	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
 	"oras.land/oras/cmd/oras/internal/argument"
 	"oras.land/oras/cmd/oras/internal/command"
 	"oras.land/oras/cmd/oras/internal/display"
@@ -35,6 +43,12 @@ type showTagsOptions struct {
 
 	last             string
 	excludeDigestTag bool
+	concurrency      int
+	artifactTypes    []string
+	mediaTypes       []string
+	match            string
+	matchRegex       string
+	limit            int
 }
 
 func showTagsCmd() *cobra.Command {
@@ -65,11 +79,29 @@ Example - [Experimental] Show tags associated with a particular tagged resource:
 Example - [Experimental] Show tags associated with a digest:
   oras repo tags localhost:5000/hello@sha256:c551125a624189cece9135981621f3f3144564ddabe14b523507bf74c2281d9b
 
+Example - [Experimental] Show tags associated with a digest, resolving up to 10 tags at a time:
+  oras repo tags localhost:5000/hello@sha256:c551125a624189cece9135981621f3f3144564ddabe14b523507bf74c2281d9b --concurrency 10
+
+Example - [Experimental] Show tags of manifests with a given artifact type:
+  oras repo tags localhost:5000/hello --artifact-type application/vnd.cncf.helm.chart.v1
+
+Example - [Experimental] Show tags of manifests with a given media type:
+  oras repo tags localhost:5000/hello --media-type application/vnd.oci.image.manifest.v1+json
+
 Example - [Experimental] Show tags of the target repository in JSON format:
   oras repo tags localhost:5000/hello --format json
 
 Example - [Experimental] Show tags of the target repository using the given Go template:
   oras repo tags localhost:5000/hello --format go-template --template "{{.tags}}"
+
+Example - [Experimental] Show tags of the target repository whose name matches a glob pattern:
+  oras repo tags --match "v1.*" localhost:5000/hello
+
+Example - [Experimental] Show tags of the target repository whose name matches a regular expression:
+  oras repo tags --match-regex "^v1\\.[0-9]+$" localhost:5000/hello
+
+Example - [Experimental] Stop after the first 10 matching tags:
+  oras repo tags --limit 10 localhost:5000/hello
 `,
 		Args:    oerrors.CheckArgs(argument.Exactly(1), "the target repository to list tags from"),
 		Aliases: []string{"show-tags"},
@@ -83,6 +115,12 @@ Example - [Experimental] Show tags of the target repository using the given Go t
 	}
 	cmd.Flags().StringVar(&opts.last, "last", "", "start after the tag specified by `last`")
 	cmd.Flags().BoolVar(&opts.excludeDigestTag, "exclude-digest-tags", false, "[Preview] exclude all digest-like tags such as 'sha256-aaaa...'")
+	cmd.Flags().IntVar(&opts.concurrency, "concurrency", 5, "[Preview] concurrency level to resolve tags when filtering by a tagged resource, digest, artifact type or media type")
+	cmd.Flags().StringArrayVar(&opts.artifactTypes, "artifact-type", nil, "[Preview] only show tags of manifests with the given artifact type (can be specified multiple times)")
+	cmd.Flags().StringArrayVar(&opts.mediaTypes, "media-type", nil, "[Preview] only show tags of manifests with the given media type (can be specified multiple times)")
+	cmd.Flags().StringVar(&opts.match, "match", "", "[Preview] only show tags that match the glob `pattern`")
+	cmd.Flags().StringVar(&opts.matchRegex, "match-regex", "", "[Preview] only show tags that match the regular `expression`")
+	cmd.Flags().IntVar(&opts.limit, "limit", 0, "[Preview] stop after `N` matching tags are found, 0 means no limit")
 	option.AddDeprecatedVerboseFlag(cmd.Flags())
 	opts.SetTypes(option.FormatTypeText, option.FormatTypeJSON, option.FormatTypeGoTemplate)
 	option.ApplyFlags(&opts, cmd.Flags())
@@ -90,6 +128,10 @@ Example - [Experimental] Show tags of the target repository using the given Go t
 }
 
 func showTags(cmd *cobra.Command, opts *showTagsOptions) error {
+	if opts.concurrency < 1 {
+		return fmt.Errorf("invalid --concurrency value %d: must be a positive integer", opts.concurrency)
+	}
+
 	ctx, logger := command.GetLogger(cmd, &opts.Common)
 	finder, err := opts.NewReadonlyTarget(ctx, opts.Common, logger)
 	if err != nil {
@@ -108,46 +150,207 @@ func showTags(cmd *cobra.Command, opts *showTagsOptions) error {
 		}
 		logger.Warnf("[Experimental] querying tags associated to %s, it may take a while...\n", filter)
 	}
+	matcher := &tagMatcher{
+		digestFilter:  filter,
+		selfReference: opts.Reference,
+		artifactTypes: opts.artifactTypes,
+		mediaTypes:    opts.mediaTypes,
+		resolve:       finder.Resolve,
+		fetchManifest: func(ctx context.Context, desc ocispec.Descriptor) ([]byte, error) {
+			rc, err := finder.Fetch(ctx, desc)
+			if err != nil {
+				return nil, err
+			}
+			defer rc.Close()
+			return io.ReadAll(rc)
+		},
+	}
+	needsManifestFilter := matcher.needsManifest()
 
+	tagFilter, err := newNameFilter(opts.match, opts.matchRegex, opts.limit)
+	if err != nil {
+		return err
+	}
 	handler, err := display.NewRepoTagsHandler(opts.Printer, opts.Format)
 	if err != nil {
 		return err
 	}
 	err = finder.Tags(ctx, opts.last, func(tags []string) error {
-		for _, tag := range tags {
-			if opts.excludeDigestTag && isDigestTag(tag) {
-				continue
-			}
-			if filter != "" {
-				if tag == opts.Reference {
+		if filter == "" && !needsManifestFilter {
+			for _, tag := range tags {
+				if opts.excludeDigestTag && isDigestTag(tag) {
+					continue
+				}
+				ok, ferr := tagFilter.accept(tag)
+				if ok {
 					if err := handler.OnTagListed(tag); err != nil {
 						return err
 					}
-					continue
 				}
-				desc, err := finder.Resolve(ctx, tag)
-				if err != nil {
-					return err
+				if ferr != nil {
+					return ferr
 				}
-				if desc.Digest.String() != filter {
-					continue
+			}
+			return nil
+		}
+
+		// evaluate the tags in this page concurrently, bounded by
+		// opts.concurrency, then emit the matches in the original
+		// lexical order returned by the registry. Note that --limit only
+		// short-circuits emission below: every tag in the current page is
+		// still resolved (and, with --artifact-type/--media-type, has its
+		// manifest fetched) before the limit can stop further pages.
+		matched, err := resolveConcurrently(ctx, opts.concurrency, tags, func(tag string) bool {
+			return opts.excludeDigestTag && isDigestTag(tag)
+		}, matcher.match)
+		if err != nil {
+			return err
+		}
+		for i, tag := range tags {
+			if !matched[i] {
+				continue
+			}
+			ok, ferr := tagFilter.accept(tag)
+			if ok {
+				if err := handler.OnTagListed(tag); err != nil {
+					return err
 				}
 			}
-			if err := handler.OnTagListed(tag); err != nil {
-				return err
+			if ferr != nil {
+				return ferr
 			}
 		}
 		return nil
 	})
-	if err != nil {
+	if err != nil && !errors.Is(err, errStopListing) {
 		return err
 	}
 
 	return handler.Render()
 }
 
+// resolveConcurrently evaluates match for every tag in tags using up to
+// concurrency workers, skipping any tag for which skip returns true. It
+// returns a slice parallel to tags recording which ones matched, preserving
+// the original order regardless of completion order.
+//
+// If concurrency is less than 1, or the worker pool cannot be acquired (for
+// example because ctx was canceled), resolveConcurrently returns an error
+// instead of silently dropping the remaining tags.
+func resolveConcurrently(ctx context.Context, concurrency int, tags []string, skip func(tag string) bool, match func(ctx context.Context, tag string) (bool, error)) ([]bool, error) {
+	if concurrency < 1 {
+		return nil, fmt.Errorf("invalid concurrency value %d: must be a positive integer", concurrency)
+	}
+
+	matched := make([]bool, len(tags))
+	eg, egCtx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(concurrency))
+	var acquireErr error
+	for i, tag := range tags {
+		if skip(tag) {
+			continue
+		}
+		i, tag := i, tag
+		if err := sem.Acquire(egCtx, 1); err != nil {
+			acquireErr = err
+			break
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+			ok, err := match(egCtx, tag)
+			if err != nil {
+				return err
+			}
+			matched[i] = ok
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	if acquireErr != nil {
+		return nil, acquireErr
+	}
+	return matched, nil
+}
+
+// tagMatcher decides whether a tag satisfies the configured digest,
+// artifact-type and media-type filters, resolving and fetching the manifest
+// only when one of those filters requires it. resolve and fetchManifest are
+// injected so the matching logic can be exercised without a live registry.
+type tagMatcher struct {
+	digestFilter  string
+	selfReference string
+	artifactTypes []string
+	mediaTypes    []string
+	resolve       func(ctx context.Context, tag string) (ocispec.Descriptor, error)
+	fetchManifest func(ctx context.Context, desc ocispec.Descriptor) ([]byte, error)
+}
+
+func (m *tagMatcher) needsManifest() bool {
+	return len(m.artifactTypes) > 0 || len(m.mediaTypes) > 0
+}
+
+func (m *tagMatcher) match(ctx context.Context, tag string) (bool, error) {
+	needsManifest := m.needsManifest()
+	var desc ocispec.Descriptor
+	switch {
+	case m.digestFilter != "" && tag == m.selfReference && !needsManifest:
+		// already known to match the digest filter; nothing else to check
+		return true, nil
+	case m.digestFilter != "" || needsManifest:
+		d, err := m.resolve(ctx, tag)
+		if err != nil {
+			return false, err
+		}
+		desc = d
+		if m.digestFilter != "" && desc.Digest.String() != m.digestFilter {
+			return false, nil
+		}
+	}
+	if !needsManifest {
+		return true, nil
+	}
+
+	data, err := m.fetchManifest(ctx, desc)
+	if err != nil {
+		return false, err
+	}
+	return matchesManifestFilters(data, m.artifactTypes, m.mediaTypes)
+}
+
+// matchesManifestFilters reports whether the mediaType/artifactType fields
+// decoded from a manifest's raw JSON satisfy the --artifact-type and
+// --media-type filters. Either filter matches if the manifest's value is
+// present in that filter's list; an empty filter list always matches.
+func matchesManifestFilters(manifestJSON []byte, artifactTypes, mediaTypes []string) (bool, error) {
+	var manifest struct {
+		MediaType    string `json:"mediaType"`
+		ArtifactType string `json:"artifactType"`
+	}
+	if err := json.Unmarshal(manifestJSON, &manifest); err != nil {
+		return false, err
+	}
+	if len(artifactTypes) > 0 && !containsString(artifactTypes, manifest.ArtifactType) {
+		return false, nil
+	}
+	if len(mediaTypes) > 0 && !containsString(mediaTypes, manifest.MediaType) {
+		return false, nil
+	}
+	return true, nil
+}
+
 func isDigestTag(tag string) bool {
 	dgst := strings.Replace(tag, "-", ":", 1)
 	_, err := digest.Parse(dgst)
 	return err == nil
 }
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
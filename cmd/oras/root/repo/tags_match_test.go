@@ -0,0 +1,231 @@
+/*
+Copyright The ORAS Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestContainsString(t *testing.T) {
+	values := []string{"a", "b", "c"}
+	if !containsString(values, "b") {
+		t.Error("containsString(values, \"b\") = false, want true")
+	}
+	if containsString(values, "z") {
+		t.Error("containsString(values, \"z\") = true, want false")
+	}
+	if containsString(nil, "a") {
+		t.Error("containsString(nil, \"a\") = true, want false")
+	}
+}
+
+func TestMatchesManifestFilters(t *testing.T) {
+	withArtifactType := []byte(`{"mediaType":"application/vnd.oci.image.manifest.v1+json","artifactType":"application/vnd.cncf.helm.chart.v1"}`)
+	withoutArtifactType := []byte(`{"mediaType":"application/vnd.oci.image.manifest.v1+json"}`)
+
+	tests := []struct {
+		name          string
+		manifest      []byte
+		artifactTypes []string
+		mediaTypes    []string
+		want          bool
+	}{
+		{"no filters matches anything", withoutArtifactType, nil, nil, true},
+		{"artifact type matches", withArtifactType, []string{"application/vnd.cncf.helm.chart.v1"}, nil, true},
+		{"artifact type matches one of several values", withArtifactType, []string{"application/vnd.cncf.sbom.v1", "application/vnd.cncf.helm.chart.v1"}, nil, true},
+		{"artifact type does not match", withArtifactType, []string{"application/vnd.cncf.sbom.v1"}, nil, false},
+		{"artifact type filter rejects manifest with no artifactType", withoutArtifactType, []string{"application/vnd.cncf.helm.chart.v1"}, nil, false},
+		{"media type matches", withoutArtifactType, nil, []string{"application/vnd.oci.image.manifest.v1+json"}, true},
+		{"media type matches one of several values", withoutArtifactType, nil, []string{"application/vnd.docker.distribution.manifest.v2+json", "application/vnd.oci.image.manifest.v1+json"}, true},
+		{"media type does not match", withoutArtifactType, nil, []string{"application/vnd.docker.distribution.manifest.v2+json"}, false},
+		{"both filters must match", withArtifactType, []string{"application/vnd.cncf.helm.chart.v1"}, []string{"application/vnd.oci.image.manifest.v1+json"}, true},
+		{"both filters, media type mismatches", withArtifactType, []string{"application/vnd.cncf.helm.chart.v1"}, []string{"application/vnd.docker.distribution.manifest.v2+json"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchesManifestFilters(tt.manifest, tt.artifactTypes, tt.mediaTypes)
+			if err != nil {
+				t.Fatalf("matchesManifestFilters: unexpected error %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("matchesManifestFilters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesManifestFilters_InvalidJSON(t *testing.T) {
+	if _, err := matchesManifestFilters([]byte("not json"), []string{"x"}, nil); err == nil {
+		t.Fatal("expected an error for a manifest that is not valid JSON")
+	}
+}
+
+const (
+	testDigestA = digest.Digest("sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	testDigestB = digest.Digest("sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+)
+
+// fakeManifests backs a tagMatcher's resolve/fetchManifest functions with an
+// in-memory tag-to-manifest map, so tagMatcher.match can be tested without a
+// live registry.
+type fakeManifests struct {
+	digests   map[string]digest.Digest
+	manifests map[digest.Digest][]byte
+}
+
+func (f *fakeManifests) resolve(_ context.Context, tag string) (ocispec.Descriptor, error) {
+	d, ok := f.digests[tag]
+	if !ok {
+		return ocispec.Descriptor{}, errors.New("not found: " + tag)
+	}
+	return ocispec.Descriptor{Digest: d}, nil
+}
+
+func (f *fakeManifests) fetchManifest(_ context.Context, desc ocispec.Descriptor) ([]byte, error) {
+	data, ok := f.manifests[desc.Digest]
+	if !ok {
+		return nil, errors.New("no manifest for digest " + desc.Digest.String())
+	}
+	return data, nil
+}
+
+func TestTagMatcher_Match(t *testing.T) {
+	backend := &fakeManifests{
+		digests: map[string]digest.Digest{
+			"helm-chart":  testDigestA,
+			"plain-image": testDigestB,
+		},
+		manifests: map[digest.Digest][]byte{
+			testDigestA: []byte(`{"mediaType":"application/vnd.oci.image.manifest.v1+json","artifactType":"application/vnd.cncf.helm.chart.v1"}`),
+			testDigestB: []byte(`{"mediaType":"application/vnd.oci.image.manifest.v1+json"}`),
+		},
+	}
+
+	tests := []struct {
+		name          string
+		tag           string
+		digestFilter  string
+		selfReference string
+		artifactTypes []string
+		mediaTypes    []string
+		want          bool
+		wantErr       bool
+	}{
+		{
+			name: "no filters matches every tag",
+			tag:  "plain-image",
+			want: true,
+		},
+		{
+			name:         "digest filter matches",
+			tag:          "helm-chart",
+			digestFilter: testDigestA.String(),
+			want:         true,
+		},
+		{
+			name:         "digest filter does not match",
+			tag:          "plain-image",
+			digestFilter: testDigestA.String(),
+			want:         false,
+		},
+		{
+			name:          "self reference short-circuits without a network round-trip",
+			tag:           "helm-chart",
+			selfReference: "helm-chart",
+			digestFilter:  testDigestA.String(),
+			want:          true,
+		},
+		{
+			name:          "artifact type matches",
+			tag:           "helm-chart",
+			artifactTypes: []string{"application/vnd.cncf.helm.chart.v1"},
+			want:          true,
+		},
+		{
+			name:          "artifact type does not match",
+			tag:           "plain-image",
+			artifactTypes: []string{"application/vnd.cncf.helm.chart.v1"},
+			want:          false,
+		},
+		{
+			name:       "media type matches",
+			tag:        "plain-image",
+			mediaTypes: []string{"application/vnd.oci.image.manifest.v1+json"},
+			want:       true,
+		},
+		{
+			name:          "digest filter combined with artifact type, both match",
+			tag:           "helm-chart",
+			digestFilter:  testDigestA.String(),
+			artifactTypes: []string{"application/vnd.cncf.helm.chart.v1"},
+			want:          true,
+		},
+		{
+			name:          "digest filter combined with artifact type, digest mismatches",
+			tag:           "plain-image",
+			digestFilter:  testDigestA.String(),
+			artifactTypes: []string{"application/vnd.cncf.helm.chart.v1"},
+			want:          false,
+		},
+		{
+			name:          "digest filter matches but artifact type mismatches",
+			tag:           "helm-chart",
+			digestFilter:  testDigestA.String(),
+			artifactTypes: []string{"application/vnd.cncf.sbom.v1"},
+			want:          false,
+		},
+		{
+			name:          "self reference combined with artifact type still fetches the manifest",
+			tag:           "plain-image",
+			selfReference: "plain-image",
+			digestFilter:  testDigestB.String(),
+			artifactTypes: []string{"application/vnd.cncf.helm.chart.v1"},
+			want:          false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &tagMatcher{
+				digestFilter:  tt.digestFilter,
+				selfReference: tt.selfReference,
+				artifactTypes: tt.artifactTypes,
+				mediaTypes:    tt.mediaTypes,
+				resolve:       backend.resolve,
+				fetchManifest: backend.fetchManifest,
+			}
+			got, err := m.match(context.Background(), tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("match() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagMatcher_Match_ResolveError(t *testing.T) {
+	backend := &fakeManifests{digests: map[string]digest.Digest{}, manifests: map[digest.Digest][]byte{}}
+	m := &tagMatcher{digestFilter: testDigestA.String(), resolve: backend.resolve, fetchManifest: backend.fetchManifest}
+	if _, err := m.match(context.Background(), "missing"); err == nil {
+		t.Fatal("expected an error when resolve fails")
+	}
+}
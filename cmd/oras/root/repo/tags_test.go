@@ -0,0 +1,102 @@
+/*
+Copyright The ORAS Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestResolveConcurrently_RejectsNonPositiveConcurrency(t *testing.T) {
+	match := func(ctx context.Context, tag string) (bool, error) { return true, nil }
+	for _, concurrency := range []int{0, -1} {
+		if _, err := resolveConcurrently(context.Background(), concurrency, []string{"v1"}, func(string) bool { return false }, match); err == nil {
+			t.Fatalf("concurrency=%d: expected an error instead of hanging or proceeding", concurrency)
+		}
+	}
+}
+
+func TestResolveConcurrently_HonorsCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	match := func(ctx context.Context, tag string) (bool, error) {
+		t.Fatalf("match should not be called once the context is already canceled")
+		return false, nil
+	}
+	tags := []string{"v1", "v2", "v3"}
+	_, err := resolveConcurrently(ctx, 1, tags, func(string) bool { return false }, match)
+	if err == nil {
+		t.Fatal("expected an error when the context is canceled before any tag is resolved, not a silently truncated result")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the error to wrap context.Canceled, got %v", err)
+	}
+}
+
+func TestResolveConcurrently_MatchesInOriginalOrder(t *testing.T) {
+	tags := []string{"a", "b", "c", "d", "e"}
+	match := func(ctx context.Context, tag string) (bool, error) {
+		return tag == "b" || tag == "d", nil
+	}
+	matched, err := resolveConcurrently(context.Background(), 2, tags, func(string) bool { return false }, match)
+	if err != nil {
+		t.Fatalf("resolveConcurrently: %v", err)
+	}
+	want := []bool{false, true, false, true, false}
+	for i := range tags {
+		if matched[i] != want[i] {
+			t.Errorf("matched[%d] (%s) = %v, want %v", i, tags[i], matched[i], want[i])
+		}
+	}
+}
+
+func TestResolveConcurrently_SkipsWithoutCallingMatch(t *testing.T) {
+	tags := []string{"sha256-aaaa", "v1"}
+	match := func(ctx context.Context, tag string) (bool, error) {
+		if tag == "sha256-aaaa" {
+			t.Fatalf("match should not be called for a skipped tag")
+		}
+		return true, nil
+	}
+	matched, err := resolveConcurrently(context.Background(), 5, tags, func(tag string) bool {
+		return tag == "sha256-aaaa"
+	}, match)
+	if err != nil {
+		t.Fatalf("resolveConcurrently: %v", err)
+	}
+	if matched[0] {
+		t.Errorf("matched[0] = true, want false for a skipped tag")
+	}
+	if !matched[1] {
+		t.Errorf("matched[1] = false, want true")
+	}
+}
+
+func TestResolveConcurrently_PropagatesMatchError(t *testing.T) {
+	wantErr := errors.New("resolve failed")
+	match := func(ctx context.Context, tag string) (bool, error) {
+		if tag == "bad" {
+			return false, wantErr
+		}
+		return true, nil
+	}
+	_, err := resolveConcurrently(context.Background(), 2, []string{"good", "bad", "good"}, func(string) bool { return false }, match)
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("resolveConcurrently error = %v, want %v", err, wantErr)
+	}
+}